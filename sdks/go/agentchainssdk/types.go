@@ -0,0 +1,180 @@
+// Package agentchainssdk contains the typed request/response models shared by
+// the AgentChains Go client. Keeping these separate from the client package
+// lets other tools (CLI, orchestration engine, tests) depend on the wire
+// types without pulling in net/http.
+package agentchainssdk
+
+import (
+	"fmt"
+	"time"
+)
+
+// Agent is a registered AgentChains marketplace agent.
+type Agent struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Owner        string    `json:"owner"`
+	Capabilities []string  `json:"capabilities,omitempty"`
+	Status       string    `json:"status"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// CreateAgentRequest is the payload for RegisterAgent.
+type CreateAgentRequest struct {
+	Name         string   `json:"name"`
+	Owner        string   `json:"owner"`
+	Capabilities []string `json:"capabilities,omitempty"`
+}
+
+// Listing is a marketplace listing offered by an agent.
+type Listing struct {
+	ID          string    `json:"id"`
+	AgentID     string    `json:"agent_id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Price       float64   `json:"price"`
+	Currency    string    `json:"currency"`
+	Status      string    `json:"status"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// CreateListingRequest is the payload for CreateListing.
+type CreateListingRequest struct {
+	AgentID     string  `json:"agent_id"`
+	Title       string  `json:"title"`
+	Description string  `json:"description,omitempty"`
+	Price       float64 `json:"price"`
+	Currency    string  `json:"currency"`
+}
+
+// Transaction records a purchase against a Listing.
+type Transaction struct {
+	ID        string    `json:"id"`
+	ListingID string    `json:"listing_id"`
+	BuyerID   string    `json:"buyer_id"`
+	SellerID  string    `json:"seller_id"`
+	Amount    float64   `json:"amount"`
+	Currency  string    `json:"currency"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CreateTransactionRequest is the payload for CreateTransaction.
+type CreateTransactionRequest struct {
+	ListingID string  `json:"listing_id"`
+	BuyerID   string  `json:"buyer_id"`
+	Amount    float64 `json:"amount"`
+	Currency  string  `json:"currency"`
+}
+
+// Workflow describes an orchestration workflow registered with the API.
+type Workflow struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Steps       []string `json:"steps,omitempty"`
+}
+
+// ExecuteWorkflowRequest is the payload for ExecuteWorkflow and StreamWorkflow.
+type ExecuteWorkflowRequest struct {
+	InputData map[string]interface{} `json:"input_data"`
+}
+
+// WorkflowExecution is the result of a single workflow run.
+type WorkflowExecution struct {
+	ID         string                 `json:"id"`
+	WorkflowID string                 `json:"workflow_id"`
+	Status     string                 `json:"status"`
+	Output     map[string]interface{} `json:"output,omitempty"`
+	StartedAt  time.Time              `json:"started_at"`
+	FinishedAt *time.Time             `json:"finished_at,omitempty"`
+}
+
+// HealthStatus is the response from the API health check.
+type HealthStatus struct {
+	Status  string `json:"status"`
+	Version string `json:"version,omitempty"`
+}
+
+// WorkflowEventType identifies the kind of progress event emitted while a
+// workflow runs, over either the SSE stream or the websocket connection.
+type WorkflowEventType string
+
+const (
+	WorkflowEventStarted       WorkflowEventType = "started"
+	WorkflowEventStepStarted   WorkflowEventType = "step_started"
+	WorkflowEventStepOutput    WorkflowEventType = "step_output"
+	WorkflowEventStepCompleted WorkflowEventType = "step_completed"
+	WorkflowEventError         WorkflowEventType = "error"
+	WorkflowEventCompleted     WorkflowEventType = "completed"
+)
+
+// WorkflowEvent is a single progress event for a running workflow execution.
+type WorkflowEvent struct {
+	Type      WorkflowEventType      `json:"type"`
+	StepID    string                 `json:"step_id,omitempty"`
+	Output    map[string]interface{} `json:"output,omitempty"`
+	Error     string                 `json:"error,omitempty"`
+	Timestamp time.Time              `json:"timestamp"`
+}
+
+// ListAgentsOptions filters and paginates ListAgents.
+type ListAgentsOptions struct {
+	Owner        string
+	Status       string
+	Capabilities []string
+	Cursor       string
+	PerPage      int
+}
+
+// AgentPage is a single page of ListAgents results.
+type AgentPage struct {
+	Agents     []Agent `json:"agents"`
+	NextCursor string  `json:"next_cursor,omitempty"`
+}
+
+// ListListingsOptions filters and paginates ListListings.
+type ListListingsOptions struct {
+	AgentID string
+	Status  string
+	Cursor  string
+	PerPage int
+}
+
+// ListingPage is a single page of ListListings results.
+type ListingPage struct {
+	Listings   []Listing `json:"listings"`
+	NextCursor string    `json:"next_cursor,omitempty"`
+}
+
+// ListTransactionsOptions filters and paginates ListTransactions.
+type ListTransactionsOptions struct {
+	ListingID string
+	BuyerID   string
+	SellerID  string
+	Status    string
+	Cursor    string
+	PerPage   int
+}
+
+// TransactionPage is a single page of ListTransactions results.
+type TransactionPage struct {
+	Transactions []Transaction `json:"transactions"`
+	NextCursor   string        `json:"next_cursor,omitempty"`
+}
+
+// APIError is returned for any AgentChains API response with a 4xx/5xx
+// status code. Callers can use errors.As to recover it and inspect Code.
+type APIError struct {
+	StatusCode int    `json:"-"`
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+}
+
+func (e *APIError) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("agentchains: %s (status %d, code %s)", e.Message, e.StatusCode, e.Code)
+	}
+	return fmt.Sprintf("agentchains: %s (status %d)", e.Message, e.StatusCode)
+}
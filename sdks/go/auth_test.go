@@ -0,0 +1,79 @@
+package agentchains
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHMACAuthAuthenticateAndVerify(t *testing.T) {
+	signer := HMACAuth{
+		KeyID:  "key-1",
+		Secret: []byte("s3cr3t"),
+		Now:    func() time.Time { return time.Unix(1000, 0) },
+	}
+
+	req, err := http.NewRequest(http.MethodPost, "https://example.com/api/v1/listings", strings.NewReader(`{"a":1}`))
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	if err := signer.Authenticate(req); err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+
+	for _, h := range []string{"X-AgentChains-Signature", "X-AgentChains-Timestamp", "X-AgentChains-Key-Id"} {
+		if req.Header.Get(h) == "" {
+			t.Errorf("missing header %s", h)
+		}
+	}
+
+	if err := signer.Verify(req, []byte(`{"a":1}`)); err != nil {
+		t.Errorf("Verify valid request: %v", err)
+	}
+
+	if err := signer.Verify(req, []byte(`{"a":2}`)); err == nil {
+		t.Error("Verify accepted a request signed for a different body")
+	}
+}
+
+func TestHMACAuthVerifyRejectsStaleTimestamp(t *testing.T) {
+	signedAt := time.Unix(1000, 0)
+	signer := HMACAuth{
+		KeyID:         "key-1",
+		Secret:        []byte("s3cr3t"),
+		SkewTolerance: time.Minute,
+		Now:           func() time.Time { return signedAt },
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "https://example.com/api/v1/agents/a1", nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	if err := signer.Authenticate(req); err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+
+	verifier := signer
+	verifier.Now = func() time.Time { return signedAt.Add(5 * time.Minute) }
+	if err := verifier.Verify(req, nil); err == nil {
+		t.Error("Verify accepted a timestamp outside SkewTolerance")
+	}
+
+	verifier.Now = func() time.Time { return signedAt.Add(30 * time.Second) }
+	if err := verifier.Verify(req, nil); err != nil {
+		t.Errorf("Verify rejected a timestamp inside SkewTolerance: %v", err)
+	}
+}
+
+func TestBasicAuthSetsCredentials(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	auth := BasicAuth{Username: "u", Password: "p"}
+	if err := auth.Authenticate(req); err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	user, pass, ok := req.BasicAuth()
+	if !ok || user != "u" || pass != "p" {
+		t.Errorf("BasicAuth() = (%q, %q, %v), want (u, p, true)", user, pass, ok)
+	}
+}
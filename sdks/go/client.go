@@ -2,101 +2,146 @@
 package agentchains
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
-	"io"
 	"net/http"
 	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/DandaAkhilReddy/agentchains/sdks/go/agentchainssdk"
 )
 
+// defaultMaxRetries is how many times an idempotent request (GET, or a POST
+// carrying a caller-supplied Idempotency-Key) is retried before giving up.
+const defaultMaxRetries = 3
+
+// idempotencyCacheTTL is how long a POST's response is cached under its
+// Idempotency-Key so a client-side retry of the same key short-circuits
+// without hitting the server again.
+const idempotencyCacheTTL = 5 * time.Minute
+
+// defaultRequestTimeout bounds each HTTP attempt made through doRequest when
+// neither the caller's context nor SetDeadline/SetReadDeadline/
+// SetWriteDeadline already impose one; a retried call gets the full timeout
+// on every attempt. It intentionally isn't set on HTTPClient.Timeout, which
+// would also cut off StreamWorkflow's long-lived SSE connections; doRequest
+// applies it per attempt instead.
+const defaultRequestTimeout = 30 * time.Second
+
 // Client is the AgentChains API client.
 type Client struct {
 	BaseURL    string
-	Token      string
+	Auth       Authenticator
 	HTTPClient *http.Client
+
+	// MaxRetries is how many additional attempts are made for idempotent
+	// requests after the first one fails. Zero disables retries.
+	MaxRetries int
+
+	// Limiter, if set, throttles outgoing requests. Configure it with
+	// SetRateLimit or by assigning a *rate.Limiter directly.
+	Limiter *rate.Limiter
+
+	// RequestTimeout bounds each attempt of a call made through doRequest
+	// (Health, GetAgent, CreateListing, etc.) when no other deadline applies;
+	// retries are not counted against it. Zero means use
+	// defaultRequestTimeout; a negative value disables the default so only
+	// the caller's context or explicit deadlines apply. It does not affect
+	// StreamWorkflow or DialWorkflow, which are expected to run long.
+	RequestTimeout time.Duration
+
+	deadlines   *clientDeadlines
+	idempotency *idempotencyCache
 }
 
-// NewClient creates a new AgentChains client.
+// NewClient creates a new AgentChains client authenticated with a static
+// bearer token. For OAuth2, HMAC-signed, or basic auth, use
+// NewClientWithAuth instead.
 func NewClient(baseURL string, token string) *Client {
-	return &Client{
-		BaseURL: baseURL,
-		Token:   token,
-		HTTPClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
-	}
+	return NewClientWithAuth(baseURL, BearerAuth{Token: token})
 }
 
-func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}) (map[string]interface{}, error) {
-	var reqBody io.Reader
-	if body != nil {
-		b, err := json.Marshal(body)
-		if err != nil {
-			return nil, fmt.Errorf("marshal body: %w", err)
-		}
-		reqBody = bytes.NewReader(b)
+// NewClientWithAuth creates a new AgentChains client using the given
+// Authenticator to sign or authorize every request.
+func NewClientWithAuth(baseURL string, auth Authenticator) *Client {
+	return &Client{
+		BaseURL:     baseURL,
+		Auth:        auth,
+		HTTPClient:  &http.Client{},
+		MaxRetries:  defaultMaxRetries,
+		deadlines:   &clientDeadlines{},
+		idempotency: newIdempotencyCache(idempotencyCacheTTL),
 	}
+}
 
-	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, reqBody)
-	if err != nil {
-		return nil, fmt.Errorf("create request: %w", err)
-	}
-	req.Header.Set("Content-Type", "application/json")
-	if c.Token != "" {
-		req.Header.Set("Authorization", "Bearer "+c.Token)
-	}
+// SetRateLimit configures a requests-per-second limit with the given burst
+// capacity for all calls made through this client.
+func (c *Client) SetRateLimit(requestsPerSecond float64, burst int) {
+	c.Limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+}
 
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("do request: %w", err)
-	}
-	defer resp.Body.Close()
+// SetDeadline sets both the read and write deadlines used for subsequent
+// requests made through this client, analogous to net.Conn.SetDeadline.
+func (c *Client) SetDeadline(t time.Time) { c.deadlines.SetDeadline(t) }
 
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("read response: %w", err)
-	}
+// SetReadDeadline sets the read deadline. Each request is bounded as a whole
+// by the earlier of the read and write deadlines; the two are not applied
+// separately to sending the request and receiving the response.
+func (c *Client) SetReadDeadline(t time.Time) { c.deadlines.SetReadDeadline(t) }
 
-	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(respBody))
-	}
+// SetWriteDeadline sets the write deadline. Each request is bounded as a
+// whole by the earlier of the read and write deadlines; the two are not
+// applied separately to sending the request and receiving the response.
+func (c *Client) SetWriteDeadline(t time.Time) { c.deadlines.SetWriteDeadline(t) }
 
-	var result map[string]interface{}
-	if err := json.Unmarshal(respBody, &result); err != nil {
-		return nil, fmt.Errorf("unmarshal response: %w", err)
+// requestTimeout resolves RequestTimeout to the duration doRequest should
+// fall back to when nothing else bounds the call.
+func (c *Client) requestTimeout() time.Duration {
+	switch {
+	case c.RequestTimeout > 0:
+		return c.RequestTimeout
+	case c.RequestTimeout < 0:
+		return 0
+	default:
+		return defaultRequestTimeout
 	}
-	return result, nil
 }
 
 // Health checks the API health.
-func (c *Client) Health(ctx context.Context) (map[string]interface{}, error) {
-	return c.doRequest(ctx, http.MethodGet, "/api/v1/health", nil)
+func (c *Client) Health(ctx context.Context) (agentchainssdk.HealthStatus, error) {
+	return doRequest[agentchainssdk.HealthStatus](ctx, c, http.MethodGet, "/api/v1/health", nil)
 }
 
 // RegisterAgent registers a new agent.
-func (c *Client) RegisterAgent(ctx context.Context, data map[string]interface{}) (map[string]interface{}, error) {
-	return c.doRequest(ctx, http.MethodPost, "/api/v1/agents", data)
+func (c *Client) RegisterAgent(ctx context.Context, req agentchainssdk.CreateAgentRequest) (agentchainssdk.Agent, error) {
+	return doRequest[agentchainssdk.Agent](ctx, c, http.MethodPost, "/api/v1/agents", req)
 }
 
 // GetAgent gets an agent by ID.
-func (c *Client) GetAgent(ctx context.Context, agentID string) (map[string]interface{}, error) {
-	return c.doRequest(ctx, http.MethodGet, "/api/v1/agents/"+agentID, nil)
+func (c *Client) GetAgent(ctx context.Context, agentID string) (agentchainssdk.Agent, error) {
+	return doRequest[agentchainssdk.Agent](ctx, c, http.MethodGet, "/api/v1/agents/"+agentID, nil)
 }
 
 // CreateListing creates a new listing.
-func (c *Client) CreateListing(ctx context.Context, data map[string]interface{}) (map[string]interface{}, error) {
-	return c.doRequest(ctx, http.MethodPost, "/api/v1/listings", data)
+func (c *Client) CreateListing(ctx context.Context, req agentchainssdk.CreateListingRequest) (agentchainssdk.Listing, error) {
+	return doRequest[agentchainssdk.Listing](ctx, c, http.MethodPost, "/api/v1/listings", req)
 }
 
 // CreateTransaction creates a new transaction.
-func (c *Client) CreateTransaction(ctx context.Context, data map[string]interface{}) (map[string]interface{}, error) {
-	return c.doRequest(ctx, http.MethodPost, "/api/v1/transactions", data)
+func (c *Client) CreateTransaction(ctx context.Context, req agentchainssdk.CreateTransactionRequest) (agentchainssdk.Transaction, error) {
+	return doRequest[agentchainssdk.Transaction](ctx, c, http.MethodPost, "/api/v1/transactions", req)
+}
+
+// ExecuteWorkflow executes a workflow and blocks until it completes.
+func (c *Client) ExecuteWorkflow(ctx context.Context, workflowID string, inputData map[string]interface{}) (agentchainssdk.WorkflowExecution, error) {
+	body := agentchainssdk.ExecuteWorkflowRequest{InputData: inputData}
+	return doRequest[agentchainssdk.WorkflowExecution](ctx, c, http.MethodPost, "/api/v3/orchestration/workflows/"+workflowID+"/execute", body)
 }
 
-// ExecuteWorkflow executes a workflow.
-func (c *Client) ExecuteWorkflow(ctx context.Context, workflowID string, inputData map[string]interface{}) (map[string]interface{}, error) {
-	body := map[string]interface{}{"input_data": inputData}
-	return c.doRequest(ctx, http.MethodPost, "/api/v3/orchestration/workflows/"+workflowID+"/execute", body)
+// PublishWorkflow uploads a workflow definition compiled by
+// orchestration.Compile, so it can be run remotely via ExecuteWorkflow or
+// StreamWorkflow instead of only against a local orchestration.Engine.
+func (c *Client) PublishWorkflow(ctx context.Context, compiled []byte) (agentchainssdk.Workflow, error) {
+	return doRequest[agentchainssdk.Workflow](ctx, c, http.MethodPost, "/api/v3/orchestration/workflows", json.RawMessage(compiled))
 }
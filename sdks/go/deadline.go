@@ -0,0 +1,72 @@
+package agentchains
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// clientDeadlines holds independent read/write deadlines for a Client,
+// mirroring the SetDeadline/SetReadDeadline/SetWriteDeadline trio that
+// net.Conn implementations expose (e.g. gVisor's gonet adapter), so a
+// long-running call like StreamWorkflow isn't at the mercy of a single
+// http.Client.Timeout.
+type clientDeadlines struct {
+	mu    sync.Mutex
+	read  time.Time
+	write time.Time
+}
+
+func (d *clientDeadlines) SetDeadline(t time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.read, d.write = t, t
+}
+
+func (d *clientDeadlines) SetReadDeadline(t time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.read = t
+}
+
+func (d *clientDeadlines) SetWriteDeadline(t time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.write = t
+}
+
+func (d *clientDeadlines) readDeadline() time.Time {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.read
+}
+
+func (d *clientDeadlines) writeDeadline() time.Time {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.write
+}
+
+// earliest returns the earliest non-zero deadline of a and b, or the zero
+// Time if neither is set.
+func earliest(a, b time.Time) time.Time {
+	switch {
+	case a.IsZero():
+		return b
+	case b.IsZero():
+		return a
+	case a.Before(b):
+		return a
+	default:
+		return b
+	}
+}
+
+// withDeadline derives a context bounded by t in addition to parent, exactly
+// like context.WithDeadline except a zero t leaves parent untouched.
+func withDeadline(parent context.Context, t time.Time) (context.Context, context.CancelFunc) {
+	if t.IsZero() {
+		return context.WithCancel(parent)
+	}
+	return context.WithDeadline(parent, t)
+}
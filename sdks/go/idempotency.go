@@ -0,0 +1,65 @@
+package agentchains
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// idempotencyEntry is a cached POST response, keyed by Idempotency-Key.
+type idempotencyEntry struct {
+	status    int
+	header    http.Header
+	body      []byte
+	expiresAt time.Time
+}
+
+// idempotencyCache briefly remembers POST responses so a client-side retry
+// of the same Idempotency-Key short-circuits instead of hitting the server
+// again.
+type idempotencyCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]idempotencyEntry
+}
+
+func newIdempotencyCache(ttl time.Duration) *idempotencyCache {
+	return &idempotencyCache{ttl: ttl, entries: make(map[string]idempotencyEntry)}
+}
+
+func (c *idempotencyCache) get(key string) (idempotencyEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return idempotencyEntry{}, false
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		return idempotencyEntry{}, false
+	}
+	return entry, true
+}
+
+func (c *idempotencyCache) put(key string, status int, header http.Header, body []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = idempotencyEntry{
+		status:    status,
+		header:    header,
+		body:      body,
+		expiresAt: time.Now().Add(c.ttl),
+	}
+	c.evictLocked()
+}
+
+// evictLocked drops expired entries. It's only ever called with a handful of
+// in-flight keys, so a linear scan is fine.
+func (c *idempotencyCache) evictLocked() {
+	now := time.Now()
+	for key, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+}
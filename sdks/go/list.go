@@ -0,0 +1,128 @@
+package agentchains
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/DandaAkhilReddy/agentchains/sdks/go/agentchainssdk"
+)
+
+const (
+	defaultPerPage = 20
+	maxPerPage     = 100
+)
+
+func clampPerPage(perPage int) int {
+	switch {
+	case perPage <= 0:
+		return defaultPerPage
+	case perPage > maxPerPage:
+		return maxPerPage
+	default:
+		return perPage
+	}
+}
+
+// ListAgents returns a single page of agents matching opts.
+func (c *Client) ListAgents(ctx context.Context, opts agentchainssdk.ListAgentsOptions) (agentchainssdk.AgentPage, error) {
+	q := url.Values{}
+	if opts.Owner != "" {
+		q.Set("owner", opts.Owner)
+	}
+	if opts.Status != "" {
+		q.Set("status", opts.Status)
+	}
+	for _, capability := range opts.Capabilities {
+		q.Add("capability", capability)
+	}
+	if opts.Cursor != "" {
+		q.Set("cursor", opts.Cursor)
+	}
+	q.Set("per_page", strconv.Itoa(clampPerPage(opts.PerPage)))
+
+	return doRequest[agentchainssdk.AgentPage](ctx, c, http.MethodGet, "/api/v1/agents?"+q.Encode(), nil)
+}
+
+// AgentsIter returns an iterator over every agent matching opts, fetching
+// subsequent pages as needed.
+func (c *Client) AgentsIter(ctx context.Context, opts agentchainssdk.ListAgentsOptions) *Iter[agentchainssdk.Agent] {
+	return newIter(ctx, opts.Cursor, func(ctx context.Context, cursor string) ([]agentchainssdk.Agent, string, error) {
+		pageOpts := opts
+		pageOpts.Cursor = cursor
+		page, err := c.ListAgents(ctx, pageOpts)
+		if err != nil {
+			return nil, "", err
+		}
+		return page.Agents, page.NextCursor, nil
+	})
+}
+
+// ListListings returns a single page of listings matching opts.
+func (c *Client) ListListings(ctx context.Context, opts agentchainssdk.ListListingsOptions) (agentchainssdk.ListingPage, error) {
+	q := url.Values{}
+	if opts.AgentID != "" {
+		q.Set("agent_id", opts.AgentID)
+	}
+	if opts.Status != "" {
+		q.Set("status", opts.Status)
+	}
+	if opts.Cursor != "" {
+		q.Set("cursor", opts.Cursor)
+	}
+	q.Set("per_page", strconv.Itoa(clampPerPage(opts.PerPage)))
+
+	return doRequest[agentchainssdk.ListingPage](ctx, c, http.MethodGet, "/api/v1/listings?"+q.Encode(), nil)
+}
+
+// ListingsIter returns an iterator over every listing matching opts,
+// fetching subsequent pages as needed.
+func (c *Client) ListingsIter(ctx context.Context, opts agentchainssdk.ListListingsOptions) *Iter[agentchainssdk.Listing] {
+	return newIter(ctx, opts.Cursor, func(ctx context.Context, cursor string) ([]agentchainssdk.Listing, string, error) {
+		pageOpts := opts
+		pageOpts.Cursor = cursor
+		page, err := c.ListListings(ctx, pageOpts)
+		if err != nil {
+			return nil, "", err
+		}
+		return page.Listings, page.NextCursor, nil
+	})
+}
+
+// ListTransactions returns a single page of transactions matching opts.
+func (c *Client) ListTransactions(ctx context.Context, opts agentchainssdk.ListTransactionsOptions) (agentchainssdk.TransactionPage, error) {
+	q := url.Values{}
+	if opts.ListingID != "" {
+		q.Set("listing_id", opts.ListingID)
+	}
+	if opts.BuyerID != "" {
+		q.Set("buyer_id", opts.BuyerID)
+	}
+	if opts.SellerID != "" {
+		q.Set("seller_id", opts.SellerID)
+	}
+	if opts.Status != "" {
+		q.Set("status", opts.Status)
+	}
+	if opts.Cursor != "" {
+		q.Set("cursor", opts.Cursor)
+	}
+	q.Set("per_page", strconv.Itoa(clampPerPage(opts.PerPage)))
+
+	return doRequest[agentchainssdk.TransactionPage](ctx, c, http.MethodGet, "/api/v1/transactions?"+q.Encode(), nil)
+}
+
+// TransactionsIter returns an iterator over every transaction matching opts,
+// fetching subsequent pages as needed.
+func (c *Client) TransactionsIter(ctx context.Context, opts agentchainssdk.ListTransactionsOptions) *Iter[agentchainssdk.Transaction] {
+	return newIter(ctx, opts.Cursor, func(ctx context.Context, cursor string) ([]agentchainssdk.Transaction, string, error) {
+		pageOpts := opts
+		pageOpts.Cursor = cursor
+		page, err := c.ListTransactions(ctx, pageOpts)
+		if err != nil {
+			return nil, "", err
+		}
+		return page.Transactions, page.NextCursor, nil
+	})
+}
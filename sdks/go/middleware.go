@@ -0,0 +1,214 @@
+package agentchains
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/DandaAkhilReddy/agentchains/sdks/go/agentchainssdk"
+)
+
+type idempotencyKeyCtxKey struct{}
+
+// WithIdempotencyKey attaches a caller-chosen Idempotency-Key to ctx. A POST
+// made with it is treated as idempotent: it's retried on transient failures
+// and a repeat call with the same key short-circuits to the cached response
+// instead of hitting the server again.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyCtxKey{}, key)
+}
+
+func idempotencyKeyFromContext(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(idempotencyKeyCtxKey{}).(string)
+	return key, ok
+}
+
+// doRequest performs an HTTP round trip and decodes the response body into T.
+// It is a free function, not a method, because Go methods cannot carry their
+// own type parameters.
+//
+// GETs, and POSTs carrying a caller-supplied Idempotency-Key (via
+// WithIdempotencyKey), are retried with exponential backoff and full jitter
+// on transient failures and 429/503 responses, honoring any Retry-After
+// header. Every POST gets an Idempotency-Key on the wire — generated
+// automatically if the caller didn't supply one — but only a
+// caller-supplied key's response is cached: a fresh UUID is unique to this
+// call, so there's no later lookup that could ever hit it, and caching it
+// anyway would just be a write-only entry wasting space until TTL eviction.
+// Callers that want a duplicate POST to short-circuit client-side must use
+// WithIdempotencyKey with a key stable across the calls they consider
+// duplicates.
+func doRequest[T any](ctx context.Context, c *Client, method, path string, body interface{}) (T, error) {
+	var zero T
+
+	var reqBody []byte
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return zero, fmt.Errorf("marshal body: %w", err)
+		}
+		reqBody = b
+	}
+
+	idempotencyKey := ""
+	callerProvidedKey := false
+	if method == http.MethodPost {
+		if key, ok := idempotencyKeyFromContext(ctx); ok && key != "" {
+			idempotencyKey, callerProvidedKey = key, true
+		} else {
+			idempotencyKey = uuid.NewString()
+		}
+		if callerProvidedKey {
+			if entry, ok := c.idempotency.get(idempotencyKey); ok {
+				if entry.status >= 400 {
+					return zero, apiErrorFromBody(entry.status, entry.body)
+				}
+				return decodeResponse[T](entry.body)
+			}
+		}
+	}
+
+	retryable := method == http.MethodGet || callerProvidedKey
+	maxAttempts := 1
+	if retryable && c.MaxRetries > 0 {
+		maxAttempts = c.MaxRetries + 1
+	}
+
+	var lastErr error
+	var nextDelay time.Duration
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			timer := time.NewTimer(nextDelay)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return zero, ctx.Err()
+			}
+		}
+
+		if c.Limiter != nil {
+			if err := c.Limiter.Wait(ctx); err != nil {
+				return zero, fmt.Errorf("rate limit: %w", err)
+			}
+		}
+
+		status, header, respBody, err := c.send(ctx, method, path, reqBody, idempotencyKey)
+		if err != nil {
+			lastErr = err
+			if retryable && attempt < maxAttempts-1 {
+				nextDelay = fullJitterBackoff(attempt)
+				continue
+			}
+			return zero, err
+		}
+
+		if status == http.StatusTooManyRequests || status == http.StatusServiceUnavailable {
+			lastErr = apiErrorFromBody(status, respBody)
+			if retryable && attempt < maxAttempts-1 {
+				if d := retryAfter(header.Get("Retry-After")); d > 0 {
+					nextDelay = d
+				} else {
+					nextDelay = fullJitterBackoff(attempt)
+				}
+				continue
+			}
+			return zero, lastErr
+		}
+
+		if status >= 400 {
+			return zero, apiErrorFromBody(status, respBody)
+		}
+
+		if method == http.MethodPost && callerProvidedKey {
+			c.idempotency.put(idempotencyKey, status, header, respBody)
+		}
+
+		return decodeResponse[T](respBody)
+	}
+}
+
+// send performs a single HTTP attempt, applying the client's configured
+// deadlines and authenticator.
+func (c *Client) send(ctx context.Context, method, path string, body []byte, idempotencyKey string) (int, http.Header, []byte, error) {
+	deadline := earliest(c.deadlines.writeDeadline(), c.deadlines.readDeadline())
+	if deadline.IsZero() {
+		if _, hasDeadline := ctx.Deadline(); !hasDeadline {
+			if timeout := c.requestTimeout(); timeout > 0 {
+				deadline = time.Now().Add(timeout)
+			}
+		}
+	}
+	reqCtx, cancel := withDeadline(ctx, deadline)
+	defer cancel()
+
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(reqCtx, method, c.BaseURL+path, reqBody)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+	if c.Auth != nil {
+		if err := c.Auth.Authenticate(req); err != nil {
+			return 0, nil, nil, fmt.Errorf("authenticate request: %w", err)
+		}
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("do request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("read response: %w", err)
+	}
+	return resp.StatusCode, resp.Header, respBody, nil
+}
+
+func decodeResponse[T any](body []byte) (T, error) {
+	var result T
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &result); err != nil {
+			var zero T
+			return zero, fmt.Errorf("unmarshal response: %w", err)
+		}
+	}
+	return result, nil
+}
+
+func apiErrorFromBody(status int, body []byte) *agentchainssdk.APIError {
+	apiErr := agentchainssdk.APIError{StatusCode: status}
+	if err := json.Unmarshal(body, &apiErr); err != nil || apiErr.Message == "" {
+		apiErr.Message = string(body)
+	}
+	return &apiErr
+}
+
+// fullJitterBackoff implements the "full jitter" strategy from AWS's
+// exponential backoff guidance: a random duration in [0, min(cap, base*2^n)).
+func fullJitterBackoff(attempt int) time.Duration {
+	const base = 200 * time.Millisecond
+	const max = 10 * time.Second
+
+	backoff := base << uint(attempt)
+	if backoff <= 0 || backoff > max {
+		backoff = max
+	}
+	return time.Duration(rand.Int63n(int64(backoff)))
+}
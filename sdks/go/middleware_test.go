@@ -0,0 +1,138 @@
+package agentchains
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DandaAkhilReddy/agentchains/sdks/go/agentchainssdk"
+)
+
+func TestDoRequestRetriesGetOn503(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"a1"}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "")
+	agent, err := c.GetAgent(context.Background(), "a1")
+	if err != nil {
+		t.Fatalf("GetAgent: %v", err)
+	}
+	if agent.ID != "a1" {
+		t.Errorf("agent.ID = %q, want a1", agent.ID)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 3 {
+		t.Errorf("server saw %d attempts, want 3", got)
+	}
+}
+
+func TestDoRequestDoesNotRetryPlainPost(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "")
+	_, err := c.RegisterAgent(context.Background(), agentchainssdk.CreateAgentRequest{Name: "n"})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if got := atomic.LoadInt32(&attempts); got != 1 {
+		t.Errorf("server saw %d attempts, want 1 (plain POSTs aren't retried)", got)
+	}
+}
+
+func TestDoRequestRetriesPostWithIdempotencyKey(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"ag1"}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "")
+	ctx := WithIdempotencyKey(context.Background(), "fixed-key")
+	agent, err := c.RegisterAgent(ctx, agentchainssdk.CreateAgentRequest{Name: "n"})
+	if err != nil {
+		t.Fatalf("RegisterAgent: %v", err)
+	}
+	if agent.ID != "ag1" {
+		t.Errorf("agent.ID = %q, want ag1", agent.ID)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Errorf("server saw %d attempts, want 2", got)
+	}
+}
+
+func TestDoRequestCachesIdempotentPostResponse(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"ag1"}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "")
+	ctx := WithIdempotencyKey(context.Background(), "repeat-key")
+
+	for i := 0; i < 2; i++ {
+		agent, err := c.RegisterAgent(ctx, agentchainssdk.CreateAgentRequest{Name: "n"})
+		if err != nil {
+			t.Fatalf("RegisterAgent call %d: %v", i, err)
+		}
+		if agent.ID != "ag1" {
+			t.Errorf("call %d: agent.ID = %q, want ag1", i, agent.ID)
+		}
+	}
+	if got := atomic.LoadInt32(&attempts); got != 1 {
+		t.Errorf("server saw %d requests, want 1 (second call should hit the idempotency cache)", got)
+	}
+}
+
+func TestDoRequestDoesNotCacheAutoGeneratedIdempotencyKey(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"ag1"}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "")
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.RegisterAgent(ctx, agentchainssdk.CreateAgentRequest{Name: "n"}); err != nil {
+			t.Fatalf("RegisterAgent call %d: %v", i, err)
+		}
+	}
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Errorf("server saw %d requests, want 2 (calls without an explicit Idempotency-Key must never be deduped)", got)
+	}
+}
+
+func TestFullJitterBackoffStaysWithinBounds(t *testing.T) {
+	for attempt := 0; attempt < 8; attempt++ {
+		d := fullJitterBackoff(attempt)
+		if d < 0 || d > 10*time.Second {
+			t.Errorf("fullJitterBackoff(%d) = %v, want within [0, 10s]", attempt, d)
+		}
+	}
+}
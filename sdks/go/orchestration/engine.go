@@ -0,0 +1,254 @@
+package orchestration
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/DandaAkhilReddy/agentchains/sdks/go/agentchainssdk"
+)
+
+// Engine runs Workflows in-process against registered StepHandlers.
+type Engine struct {
+	mu       sync.Mutex
+	handlers map[string]StepHandler
+}
+
+// NewEngine creates an empty Engine.
+func NewEngine() *Engine {
+	return &Engine{handlers: make(map[string]StepHandler)}
+}
+
+// Register associates a step name with the handler that executes it.
+func (e *Engine) Register(name string, handler StepHandler) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.handlers[name] = handler
+}
+
+func (e *Engine) handler(name string) (StepHandler, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	h, ok := e.handlers[name]
+	return h, ok
+}
+
+// Execute runs wf to completion, scheduling each DAG level with up to
+// wf.Parallelism steps running concurrently, and returns a channel of the
+// same WorkflowEvent values the remote orchestrator's stream produces. The
+// channel is closed when the run ends, whether it succeeds or fails.
+func (e *Engine) Execute(ctx context.Context, wf Workflow, input map[string]interface{}) (<-chan agentchainssdk.WorkflowEvent, error) {
+	levels, err := topoSort(wf)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan agentchainssdk.WorkflowEvent)
+	go e.run(ctx, wf, levels, input, events)
+	return events, nil
+}
+
+func (e *Engine) run(ctx context.Context, wf Workflow, levels [][]string, input map[string]interface{}, events chan<- agentchainssdk.WorkflowEvent) {
+	defer close(events)
+
+	send := func(ev agentchainssdk.WorkflowEvent) {
+		ev.Timestamp = time.Now()
+		select {
+		case events <- ev:
+		case <-ctx.Done():
+		}
+	}
+
+	send(agentchainssdk.WorkflowEvent{Type: agentchainssdk.WorkflowEventStarted})
+
+	nodeByID := make(map[string]Node, len(wf.Nodes))
+	for _, n := range wf.Nodes {
+		nodeByID[n.ID] = n
+	}
+
+	limit := wf.Parallelism
+	if limit <= 0 {
+		limit = len(wf.Nodes)
+	}
+	if limit <= 0 {
+		limit = 1
+	}
+	sem := make(chan struct{}, limit)
+
+	var outputs sync.Map // node ID -> map[string]interface{}
+	var failed atomic.Bool
+
+	for _, level := range levels {
+		if failed.Load() || ctx.Err() != nil {
+			break
+		}
+
+		var wg sync.WaitGroup
+		for _, nodeID := range level {
+			node := nodeByID[nodeID]
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(node Node) {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				if failed.Load() || ctx.Err() != nil {
+					return
+				}
+
+				send(agentchainssdk.WorkflowEvent{Type: agentchainssdk.WorkflowEventStepStarted, StepID: node.ID})
+
+				out, err := e.runStep(ctx, node, mergeInput(input, node, wf.Edges, &outputs))
+				if err != nil {
+					failed.Store(true)
+					send(agentchainssdk.WorkflowEvent{Type: agentchainssdk.WorkflowEventError, StepID: node.ID, Error: err.Error()})
+					return
+				}
+
+				outputs.Store(node.ID, out)
+				send(agentchainssdk.WorkflowEvent{Type: agentchainssdk.WorkflowEventStepOutput, StepID: node.ID, Output: out})
+				send(agentchainssdk.WorkflowEvent{Type: agentchainssdk.WorkflowEventStepCompleted, StepID: node.ID})
+			}(node)
+		}
+		wg.Wait()
+	}
+
+	if failed.Load() || ctx.Err() != nil {
+		return
+	}
+	send(agentchainssdk.WorkflowEvent{Type: agentchainssdk.WorkflowEventCompleted})
+}
+
+// runStep executes a single node, applying its timeout and retry policy.
+func (e *Engine) runStep(ctx context.Context, node Node, input map[string]interface{}) (map[string]interface{}, error) {
+	handler, ok := e.handler(node.Step)
+	if !ok {
+		return nil, fmt.Errorf("orchestration: no handler registered for step %q", node.Step)
+	}
+
+	attempts := node.Retry.MaxAttempts
+	if attempts <= 0 {
+		attempts = 1
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			timer := time.NewTimer(backoffDelay(node.Retry, attempt))
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, ctx.Err()
+			}
+		}
+
+		stepCtx := ctx
+		if node.Timeout > 0 {
+			var cancel context.CancelFunc
+			stepCtx, cancel = context.WithTimeout(ctx, node.Timeout)
+			out, err := handler.Handle(stepCtx, input)
+			cancel()
+			if err == nil {
+				return out, nil
+			}
+			lastErr = err
+			continue
+		}
+
+		out, err := handler.Handle(stepCtx, input)
+		if err == nil {
+			return out, nil
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// backoffDelay computes the delay before a retry attempt (1 for the first
+// retry), doubling from BaseDelay up to MaxDelay.
+func backoffDelay(policy RetryPolicy, attempt int) time.Duration {
+	base := policy.BaseDelay
+	if base <= 0 {
+		base = 200 * time.Millisecond
+	}
+	max := policy.MaxDelay
+	if max <= 0 {
+		max = 30 * time.Second
+	}
+	delay := base << uint(attempt-1)
+	if delay <= 0 || delay > max {
+		delay = max
+	}
+	return delay
+}
+
+// mergeInput combines the workflow's global input, the node's own static
+// input, and the outputs of its upstream nodes (keyed by their node ID).
+func mergeInput(global map[string]interface{}, node Node, edges []Edge, outputs *sync.Map) map[string]interface{} {
+	merged := make(map[string]interface{}, len(global)+len(node.Input))
+	for k, v := range global {
+		merged[k] = v
+	}
+	for k, v := range node.Input {
+		merged[k] = v
+	}
+	for _, edge := range edges {
+		if edge.To != node.ID {
+			continue
+		}
+		if out, ok := outputs.Load(edge.From); ok {
+			merged[edge.From] = out
+		}
+	}
+	return merged
+}
+
+// topoSort groups wf's nodes into levels via Kahn's algorithm; nodes within
+// a level have no dependency on each other and may run concurrently.
+func topoSort(wf Workflow) ([][]string, error) {
+	indegree := make(map[string]int, len(wf.Nodes))
+	adjacent := make(map[string][]string)
+	for _, n := range wf.Nodes {
+		indegree[n.ID] = 0
+	}
+	for _, e := range wf.Edges {
+		adjacent[e.From] = append(adjacent[e.From], e.To)
+		indegree[e.To]++
+	}
+
+	var current []string
+	for id, deg := range indegree {
+		if deg == 0 {
+			current = append(current, id)
+		}
+	}
+	sort.Strings(current)
+
+	var levels [][]string
+	remaining := len(wf.Nodes)
+	for len(current) > 0 {
+		levels = append(levels, current)
+		remaining -= len(current)
+
+		var next []string
+		for _, id := range current {
+			for _, to := range adjacent[id] {
+				indegree[to]--
+				if indegree[to] == 0 {
+					next = append(next, to)
+				}
+			}
+		}
+		sort.Strings(next)
+		current = next
+	}
+
+	if remaining > 0 {
+		return nil, fmt.Errorf("orchestration: workflow %q has a cycle", wf.ID)
+	}
+	return levels, nil
+}
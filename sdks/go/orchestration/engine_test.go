@@ -0,0 +1,138 @@
+package orchestration
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DandaAkhilReddy/agentchains/sdks/go/agentchainssdk"
+)
+
+func TestTopoSortOrdersByDependency(t *testing.T) {
+	wf := Workflow{
+		ID:    "wf-1",
+		Nodes: []Node{{ID: "a"}, {ID: "b"}, {ID: "c"}},
+		Edges: []Edge{{From: "a", To: "b"}, {From: "b", To: "c"}},
+	}
+
+	levels, err := topoSort(wf)
+	if err != nil {
+		t.Fatalf("topoSort: %v", err)
+	}
+	want := [][]string{{"a"}, {"b"}, {"c"}}
+	if len(levels) != len(want) {
+		t.Fatalf("levels = %v, want %v", levels, want)
+	}
+	for i := range want {
+		if len(levels[i]) != 1 || levels[i][0] != want[i][0] {
+			t.Errorf("level %d = %v, want %v", i, levels[i], want[i])
+		}
+	}
+}
+
+func TestTopoSortDetectsCycle(t *testing.T) {
+	wf := Workflow{
+		ID:    "wf-cycle",
+		Nodes: []Node{{ID: "a"}, {ID: "b"}},
+		Edges: []Edge{{From: "a", To: "b"}, {From: "b", To: "a"}},
+	}
+
+	if _, err := topoSort(wf); err == nil {
+		t.Fatal("topoSort did not detect a cycle")
+	}
+}
+
+func TestEngineExecuteRunsStepsInDependencyOrder(t *testing.T) {
+	e := NewEngine()
+
+	var order []string
+	e.Register("double", StepHandlerFunc(func(_ context.Context, input map[string]interface{}) (map[string]interface{}, error) {
+		order = append(order, "a")
+		return map[string]interface{}{"value": 2}, nil
+	}))
+	e.Register("increment", StepHandlerFunc(func(_ context.Context, input map[string]interface{}) (map[string]interface{}, error) {
+		order = append(order, "b")
+		upstream, _ := input["a"].(map[string]interface{})
+		return map[string]interface{}{"value": upstream["value"].(int) + 1}, nil
+	}))
+
+	wf := Workflow{
+		ID: "wf-2",
+		Nodes: []Node{
+			{ID: "a", Step: "double"},
+			{ID: "b", Step: "increment"},
+		},
+		Edges: []Edge{{From: "a", To: "b"}},
+	}
+
+	events, err := e.Execute(context.Background(), wf, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+
+	var types []agentchainssdk.WorkflowEventType
+	var finalOutput map[string]interface{}
+	for ev := range events {
+		types = append(types, ev.Type)
+		if ev.Type == agentchainssdk.WorkflowEventStepOutput && ev.StepID == "b" {
+			finalOutput = ev.Output
+		}
+	}
+
+	if len(order) != 2 || order[0] != "a" || order[1] != "b" {
+		t.Errorf("execution order = %v, want [a b]", order)
+	}
+	if types[0] != agentchainssdk.WorkflowEventStarted || types[len(types)-1] != agentchainssdk.WorkflowEventCompleted {
+		t.Errorf("events = %v, want to start with Started and end with Completed", types)
+	}
+	if finalOutput == nil || finalOutput["value"] != 3 {
+		t.Errorf("final output = %v, want value=3", finalOutput)
+	}
+}
+
+func TestEngineExecuteRetriesFailingStep(t *testing.T) {
+	e := NewEngine()
+
+	var attempts int32
+	e.Register("flaky", StepHandlerFunc(func(_ context.Context, _ map[string]interface{}) (map[string]interface{}, error) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			return nil, errors.New("transient")
+		}
+		return map[string]interface{}{"ok": true}, nil
+	}))
+
+	wf := Workflow{
+		ID: "wf-retry",
+		Nodes: []Node{
+			{ID: "a", Step: "flaky", Retry: RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}},
+		},
+	}
+
+	events, err := e.Execute(context.Background(), wf, nil)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+
+	sawError := false
+	sawCompleted := false
+	for ev := range events {
+		switch ev.Type {
+		case agentchainssdk.WorkflowEventError:
+			sawError = true
+		case agentchainssdk.WorkflowEventCompleted:
+			sawCompleted = true
+		}
+	}
+
+	if sawError {
+		t.Error("saw an Error event even though the step eventually succeeded")
+	}
+	if !sawCompleted {
+		t.Error("never saw a Completed event")
+	}
+	if got := atomic.LoadInt32(&attempts); got != 3 {
+		t.Errorf("handler called %d times, want 3", got)
+	}
+}
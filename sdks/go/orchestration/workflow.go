@@ -0,0 +1,71 @@
+// Package orchestration lets a Workflow be authored, dry-run, and tested
+// in-process against registered StepHandlers, producing the same event
+// stream the remote /api/v3/orchestration endpoint would. A Workflow built
+// this way can also be Compiled and uploaded with Client.PublishWorkflow to
+// run for real against the remote orchestrator.
+package orchestration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// RetryPolicy controls how a failed step is retried.
+type RetryPolicy struct {
+	MaxAttempts int           `json:"max_attempts,omitempty"`
+	BaseDelay   time.Duration `json:"base_delay,omitempty"`
+	MaxDelay    time.Duration `json:"max_delay,omitempty"`
+}
+
+// Node is a single step in a Workflow's DAG.
+type Node struct {
+	ID      string                 `json:"id"`
+	Step    string                 `json:"step"`
+	Input   map[string]interface{} `json:"input,omitempty"`
+	Timeout time.Duration          `json:"timeout,omitempty"`
+	Retry   RetryPolicy            `json:"retry,omitempty"`
+}
+
+// Edge makes From's output available to To and orders To to run after From.
+type Edge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// Workflow is a DAG of Nodes connected by Edges.
+type Workflow struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+
+	Nodes []Node `json:"nodes"`
+	Edges []Edge `json:"edges"`
+
+	// Parallelism caps how many steps from the same DAG level run at once.
+	// Zero means unbounded.
+	Parallelism int `json:"parallelism,omitempty"`
+}
+
+// StepHandler executes a single workflow step.
+type StepHandler interface {
+	Handle(ctx context.Context, input map[string]interface{}) (map[string]interface{}, error)
+}
+
+// StepHandlerFunc adapts a plain function to a StepHandler.
+type StepHandlerFunc func(ctx context.Context, input map[string]interface{}) (map[string]interface{}, error)
+
+// Handle implements StepHandler.
+func (f StepHandlerFunc) Handle(ctx context.Context, input map[string]interface{}) (map[string]interface{}, error) {
+	return f(ctx, input)
+}
+
+// Compile serializes a Workflow to the wire format expected by
+// Client.PublishWorkflow.
+func Compile(wf Workflow) ([]byte, error) {
+	b, err := json.Marshal(wf)
+	if err != nil {
+		return nil, fmt.Errorf("orchestration: compile workflow: %w", err)
+	}
+	return b, nil
+}
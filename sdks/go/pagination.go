@@ -0,0 +1,65 @@
+package agentchains
+
+import "context"
+
+// Iter is a cursor-driven iterator that transparently fetches subsequent
+// pages as it's walked. Use it as:
+//
+//	it := client.AgentsIter(ctx, opts)
+//	for it.Next() {
+//	    agent := it.Value()
+//	}
+//	if err := it.Err(); err != nil { ... }
+type Iter[T any] struct {
+	ctx   context.Context
+	fetch func(ctx context.Context, cursor string) ([]T, string, error)
+
+	items []T
+	idx   int
+
+	cursor string
+	done   bool
+	err    error
+}
+
+func newIter[T any](ctx context.Context, startCursor string, fetch func(context.Context, string) ([]T, string, error)) *Iter[T] {
+	return &Iter[T]{ctx: ctx, cursor: startCursor, fetch: fetch}
+}
+
+// Next advances the iterator, fetching the next page if the current one is
+// exhausted. It returns false at the end of the sequence or on error; check
+// Err to distinguish the two.
+func (it *Iter[T]) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	for it.idx >= len(it.items) {
+		if it.done {
+			return false
+		}
+		items, next, err := it.fetch(it.ctx, it.cursor)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.items = items
+		it.idx = 0
+		it.cursor = next
+		if next == "" {
+			it.done = true
+		}
+	}
+	it.idx++
+	return true
+}
+
+// Value returns the item at the iterator's current position. Only valid
+// after a call to Next that returned true.
+func (it *Iter[T]) Value() T {
+	return it.items[it.idx-1]
+}
+
+// Err returns the error, if any, that stopped iteration.
+func (it *Iter[T]) Err() error {
+	return it.err
+}
@@ -0,0 +1,68 @@
+package agentchains
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestIterWalksAllPages(t *testing.T) {
+	pages := map[string][]int{
+		"":     {1, 2},
+		"next": {3},
+	}
+	nextCursor := map[string]string{
+		"":     "next",
+		"next": "",
+	}
+
+	it := newIter(context.Background(), "", func(_ context.Context, cursor string) ([]int, string, error) {
+		return pages[cursor], nextCursor[cursor], nil
+	})
+
+	var got []int
+	for it.Next() {
+		got = append(got, it.Value())
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Err: %v", err)
+	}
+
+	want := []int{1, 2, 3}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("item %d = %d, want %d", i, got[i], want[i])
+		}
+	}
+}
+
+func TestIterStopsOnFetchError(t *testing.T) {
+	wantErr := errors.New("boom")
+	it := newIter(context.Background(), "", func(_ context.Context, cursor string) ([]int, string, error) {
+		return nil, "", wantErr
+	})
+
+	if it.Next() {
+		t.Fatal("Next() = true, want false on fetch error")
+	}
+	if !errors.Is(it.Err(), wantErr) {
+		t.Errorf("Err() = %v, want %v", it.Err(), wantErr)
+	}
+}
+
+func TestClampPerPage(t *testing.T) {
+	cases := map[int]int{
+		0:   defaultPerPage,
+		-5:  defaultPerPage,
+		10:  10,
+		500: maxPerPage,
+	}
+	for in, want := range cases {
+		if got := clampPerPage(in); got != want {
+			t.Errorf("clampPerPage(%d) = %d, want %d", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package agentchains
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DandaAkhilReddy/agentchains/sdks/go/agentchainssdk"
+)
+
+// retryableStreamError marks a StreamWorkflow failure as transient (a dropped
+// connection or a 429/5xx from the server) so the reconnect loop retries
+// instead of surfacing it to the caller.
+type retryableStreamError struct {
+	err error
+}
+
+func (e *retryableStreamError) Error() string { return e.err.Error() }
+func (e *retryableStreamError) Unwrap() error { return e.err }
+
+// StreamWorkflow executes a workflow and streams its progress over Server-Sent
+// Events. The returned event channel is closed when the stream ends for good
+// (server completion, context cancellation, or a non-retryable error); at
+// most one value is ever sent on the error channel, which is closed right
+// after.
+//
+// Dropped connections are retried with the server's `retry:` hint (or a 1s
+// default), resuming from the last seen event via the `Last-Event-ID` header.
+func (c *Client) StreamWorkflow(ctx context.Context, workflowID string, input map[string]interface{}) (<-chan agentchainssdk.WorkflowEvent, <-chan error) {
+	events := make(chan agentchainssdk.WorkflowEvent)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(events)
+		defer close(errs)
+
+		lastEventID := ""
+		retryDelay := time.Second
+
+		for {
+			delay, err := c.streamOnce(ctx, workflowID, input, &lastEventID, events)
+			if ctx.Err() != nil {
+				return
+			}
+			if err == nil {
+				return
+			}
+
+			var retryable *retryableStreamError
+			if !errors.As(err, &retryable) {
+				errs <- err
+				return
+			}
+			if delay > 0 {
+				retryDelay = delay
+			}
+
+			select {
+			case <-time.After(retryDelay):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, errs
+}
+
+// streamOnce opens a single SSE connection and forwards decoded events until
+// the connection ends, returning the server-suggested retry delay (from a
+// `retry:` frame, or from Retry-After on a 429/5xx response, if any)
+// alongside the terminal error.
+func (c *Client) streamOnce(ctx context.Context, workflowID string, input map[string]interface{}, lastEventID *string, events chan<- agentchainssdk.WorkflowEvent) (time.Duration, error) {
+	body, err := json.Marshal(agentchainssdk.ExecuteWorkflowRequest{InputData: input})
+	if err != nil {
+		return 0, fmt.Errorf("marshal input: %w", err)
+	}
+
+	// Unlike doRequest, this does not fall back to c.requestTimeout(): that
+	// default exists for doRequest's bounded calls (Health, GetAgent, ...)
+	// and would otherwise cut a healthy stream off after 30s. Only an
+	// explicit SetReadDeadline/SetWriteDeadline, or a deadline already on
+	// ctx, bounds a stream connection.
+	deadline := earliest(c.deadlines.writeDeadline(), c.deadlines.readDeadline())
+	reqCtx, cancel := withDeadline(ctx, deadline)
+	defer cancel()
+
+	path := "/api/v3/orchestration/workflows/" + workflowID + "/stream"
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, c.BaseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "text/event-stream")
+	if c.Auth != nil {
+		if err := c.Auth.Authenticate(req); err != nil {
+			return 0, fmt.Errorf("authenticate request: %w", err)
+		}
+	}
+	if *lastEventID != "" {
+		req.Header.Set("Last-Event-ID", *lastEventID)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return 0, &retryableStreamError{fmt.Errorf("do request: %w", err)}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return retryAfter(resp.Header.Get("Retry-After")), &retryableStreamError{apiErrorFromBody(resp.StatusCode, respBody)}
+	}
+	if resp.StatusCode >= 400 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return 0, apiErrorFromBody(resp.StatusCode, respBody)
+	}
+
+	var retry time.Duration
+	var eventName string
+	var dataLines []string
+	sawTerminal := false
+
+	flush := func() error {
+		if len(dataLines) == 0 {
+			eventName = ""
+			return nil
+		}
+		data := strings.Join(dataLines, "\n")
+		dataLines = nil
+
+		var ev agentchainssdk.WorkflowEvent
+		if err := json.Unmarshal([]byte(data), &ev); err != nil {
+			return fmt.Errorf("unmarshal event: %w", err)
+		}
+		if eventName != "" && ev.Type == "" {
+			ev.Type = agentchainssdk.WorkflowEventType(eventName)
+		}
+		eventName = ""
+		if ev.Type == agentchainssdk.WorkflowEventCompleted || ev.Type == agentchainssdk.WorkflowEventError {
+			sawTerminal = true
+		}
+
+		select {
+		case events <- ev:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		return nil
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+	for scanner.Scan() {
+		if ctx.Err() != nil {
+			return retry, ctx.Err()
+		}
+
+		line := scanner.Text()
+		switch {
+		case line == "":
+			if err := flush(); err != nil {
+				return retry, err
+			}
+		case strings.HasPrefix(line, ":"):
+			// Comment / heartbeat frame; not a data-bearing event.
+		case strings.HasPrefix(line, "event:"):
+			eventName = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			dataLines = append(dataLines, strings.TrimSpace(strings.TrimPrefix(line, "data:")))
+		case strings.HasPrefix(line, "id:"):
+			*lastEventID = strings.TrimSpace(strings.TrimPrefix(line, "id:"))
+		case strings.HasPrefix(line, "retry:"):
+			if ms, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "retry:"))); err == nil {
+				retry = time.Duration(ms) * time.Millisecond
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return retry, err
+	}
+	if err := scanner.Err(); err != nil {
+		return retry, &retryableStreamError{fmt.Errorf("read stream: %w", err)}
+	}
+	if !sawTerminal {
+		// The connection closed cleanly (no scanner.Err()) but we never saw a
+		// Completed or Error event — e.g. a proxy idle timeout or load
+		// balancer recycling the connection mid-workflow. That's
+		// indistinguishable from a real completion unless we check for it
+		// explicitly, so treat it as a dropped connection and let the caller
+		// reconnect rather than silently ending the stream as if it succeeded.
+		return retry, &retryableStreamError{fmt.Errorf("stream closed before a completed or error event")}
+	}
+	return retry, nil
+}
+
+// retryAfter parses a Retry-After header (seconds form) into a duration,
+// falling back to zero so the caller's default backoff applies.
+func retryAfter(header string) time.Duration {
+	if header == "" {
+		return 0
+	}
+	secs, err := strconv.Atoi(header)
+	if err != nil || secs < 0 {
+		return 0
+	}
+	return time.Duration(secs) * time.Second
+}
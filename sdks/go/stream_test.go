@@ -0,0 +1,117 @@
+package agentchains
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DandaAkhilReddy/agentchains/sdks/go/agentchainssdk"
+)
+
+func TestStreamOnceParsesSSEFrames(t *testing.T) {
+	const body = "" +
+		":heartbeat, ignore me\n" +
+		"retry: 2000\n" +
+		"event: step_started\n" +
+		"id: evt-1\n" +
+		"data: {\"step_id\":\"a\"}\n" +
+		"\n" +
+		"data: {\"type\":\"completed\"}\n" +
+		"\n"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "")
+	events := make(chan agentchainssdk.WorkflowEvent, 10)
+	lastEventID := ""
+
+	retry, err := c.streamOnce(context.Background(), "wf-1", nil, &lastEventID, events)
+	if err != nil {
+		t.Fatalf("streamOnce: %v", err)
+	}
+	close(events)
+
+	var got []agentchainssdk.WorkflowEvent
+	for ev := range events {
+		got = append(got, ev)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("got %d events, want 2: %+v", len(got), got)
+	}
+	if got[0].Type != agentchainssdk.WorkflowEventStepStarted || got[0].StepID != "a" {
+		t.Errorf("first event = %+v, want type=step_started step_id=a", got[0])
+	}
+	if got[1].Type != agentchainssdk.WorkflowEventCompleted {
+		t.Errorf("second event = %+v, want type=completed", got[1])
+	}
+	if lastEventID != "evt-1" {
+		t.Errorf("lastEventID = %q, want %q", lastEventID, "evt-1")
+	}
+	if retry != 2*time.Second {
+		t.Errorf("retry = %v, want 2s", retry)
+	}
+}
+
+func TestStreamOnceSendsLastEventID(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Last-Event-ID"); got != "evt-7" {
+			t.Errorf("Last-Event-ID header = %q, want %q", got, "evt-7")
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("data: {\"type\":\"completed\"}\n\n"))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "")
+	events := make(chan agentchainssdk.WorkflowEvent, 1)
+	lastEventID := "evt-7"
+
+	if _, err := c.streamOnce(context.Background(), "wf-1", nil, &lastEventID, events); err != nil {
+		t.Fatalf("streamOnce: %v", err)
+	}
+}
+
+func TestStreamOnceTreatsPrematureEOFAsRetryable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		// A step_started event, then the connection closes cleanly without a
+		// completed or error event ever arriving (e.g. a proxy idle timeout).
+		_, _ = w.Write([]byte("data: {\"type\":\"step_started\",\"step_id\":\"a\"}\n\n"))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "")
+	events := make(chan agentchainssdk.WorkflowEvent, 10)
+	lastEventID := ""
+
+	_, err := c.streamOnce(context.Background(), "wf-1", nil, &lastEventID, events)
+	var retryable *retryableStreamError
+	if !errors.As(err, &retryable) {
+		t.Fatalf("streamOnce error = %v, want a *retryableStreamError so StreamWorkflow reconnects instead of treating this as success", err)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	cases := map[string]time.Duration{
+		"":     0,
+		"5":    5 * time.Second,
+		"-1":   0,
+		"nope": 0,
+	}
+	for header, want := range cases {
+		if got := retryAfter(header); got != want {
+			t.Errorf("retryAfter(%q) = %v, want %v", header, got, want)
+		}
+	}
+}
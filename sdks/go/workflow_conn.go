@@ -0,0 +1,84 @@
+package agentchains
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"golang.org/x/net/websocket"
+
+	"github.com/DandaAkhilReddy/agentchains/sdks/go/agentchainssdk"
+)
+
+// WorkflowConn is a full-duplex websocket connection to a running workflow,
+// used for step feedback that a one-way SSE stream can't carry (e.g. a step
+// handler prompting for input mid-run).
+type WorkflowConn struct {
+	ws *websocket.Conn
+}
+
+// DialWorkflow opens a websocket connection to a workflow execution. The
+// connection is closed automatically if ctx is canceled.
+func (c *Client) DialWorkflow(ctx context.Context, workflowID string) (*WorkflowConn, error) {
+	wsURL, err := toWebSocketURL(c.BaseURL, "/api/v3/orchestration/workflows/"+workflowID+"/ws")
+	if err != nil {
+		return nil, fmt.Errorf("build websocket url: %w", err)
+	}
+
+	config, err := websocket.NewConfig(wsURL, c.BaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("build websocket config: %w", err)
+	}
+	if c.Auth != nil {
+		// A real method and URL are required here, not just Header: HMACAuth
+		// signs over req.Method and req.URL.Path, and would nil-panic on a
+		// bare &http.Request{Header: ...}.
+		authReq := &http.Request{Method: http.MethodGet, URL: config.Location, Header: config.Header}
+		if err := c.Auth.Authenticate(authReq); err != nil {
+			return nil, fmt.Errorf("authenticate request: %w", err)
+		}
+	}
+
+	ws, err := websocket.DialConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("dial websocket: %w", err)
+	}
+
+	conn := &WorkflowConn{ws: ws}
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+	return conn, nil
+}
+
+// Send writes a step feedback event to the workflow.
+func (wc *WorkflowConn) Send(event agentchainssdk.WorkflowEvent) error {
+	return websocket.JSON.Send(wc.ws, event)
+}
+
+// Recv blocks for the next event from the workflow.
+func (wc *WorkflowConn) Recv() (agentchainssdk.WorkflowEvent, error) {
+	var event agentchainssdk.WorkflowEvent
+	err := websocket.JSON.Receive(wc.ws, &event)
+	return event, err
+}
+
+// Close closes the underlying websocket connection.
+func (wc *WorkflowConn) Close() error {
+	return wc.ws.Close()
+}
+
+// toWebSocketURL rewrites an http(s) base URL to its ws(s) equivalent and
+// appends path.
+func toWebSocketURL(baseURL, path string) (string, error) {
+	switch {
+	case strings.HasPrefix(baseURL, "https://"):
+		return "wss://" + strings.TrimPrefix(baseURL, "https://") + path, nil
+	case strings.HasPrefix(baseURL, "http://"):
+		return "ws://" + strings.TrimPrefix(baseURL, "http://") + path, nil
+	default:
+		return "", fmt.Errorf("unsupported base URL scheme: %q", baseURL)
+	}
+}
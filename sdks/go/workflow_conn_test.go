@@ -0,0 +1,24 @@
+package agentchains
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/net/websocket"
+)
+
+func TestDialWorkflowWithHMACAuthDoesNotPanic(t *testing.T) {
+	srv := httptest.NewServer(websocket.Handler(func(ws *websocket.Conn) {
+		ws.Close()
+	}))
+	defer srv.Close()
+
+	c := NewClientWithAuth(srv.URL, HMACAuth{KeyID: "key-1", Secret: []byte("s3cr3t")})
+
+	conn, err := c.DialWorkflow(context.Background(), "wf-1")
+	if err != nil {
+		t.Fatalf("DialWorkflow: %v", err)
+	}
+	defer conn.Close()
+}